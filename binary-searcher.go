@@ -16,6 +16,22 @@ type BinarySearcher struct {
 
 	IncrementBytes func(base, data []byte)
 
+	// CompareFunc orders two Size-byte records, returning a negative
+	// number, zero or a positive number if a is respectively less
+	// than, equal to or greater than b. A nil CompareFunc uses
+	// bytes.Compare, the previous hard-coded behaviour.
+	//
+	// This parallels slices.BinarySearchFunc: it lets callers use
+	// orderings other than lexical byte comparison, such as
+	// little-endian fixed-width integer keys or composite records
+	// keyed on only their first N bytes.
+	//
+	// InsertRange and RemoveRange presume the default, lexical
+	// ordering, since IncrementBytes computes successors in that
+	// order; they must not be used together with a non-nil
+	// CompareFunc that disagrees with bytes.Compare.
+	CompareFunc func(a, b []byte) int
+
 	buffer []byte
 }
 
@@ -32,8 +48,17 @@ func (s *BinarySearcher) Len() int {
 	return len(s.Data) / s.Size
 }
 
+// compare orders a and b using CompareFunc, falling back to
+// bytes.Compare if it is nil.
+func (s *BinarySearcher) compare(a, b []byte) int {
+	if s.CompareFunc != nil {
+		return s.CompareFunc(a, b)
+	}
+	return bytes.Compare(a, b)
+}
+
 func (s *BinarySearcher) Less(i, j int) bool {
-	return bytes.Compare(s.Data[i*s.Size:(i+1)*s.Size], s.Data[j*s.Size:(j+1)*s.Size]) < 0
+	return s.compare(s.Data[i*s.Size:(i+1)*s.Size], s.Data[j*s.Size:(j+1)*s.Size]) < 0
 }
 
 func (s *BinarySearcher) Swap(i, j int) {
@@ -50,20 +75,33 @@ func (s *BinarySearcher) Sort() {
 	sort.Sort(s)
 }
 
+// Index returns the position of check in Data, or the position it
+// would be inserted at if it is not present.
+//
+// Deprecated: use Find instead, which also reports whether check was
+// found, avoiding a second comparison at the call site.
 func (s *BinarySearcher) Index(check []byte) int {
+	pos, _ := s.Find(check)
+	return pos
+}
+
+// Find returns the leftmost position of check in Data and reports
+// whether it was found, mirroring the convention of
+// golang.org/x/exp/slices.BinarySearch.
+func (s *BinarySearcher) Find(check []byte) (pos int, found bool) {
 	if len(check) != s.Size {
 		panic("invalid size")
 	}
 
-	return sort.Search(s.Len(), func(i int) bool {
-		return bytes.Compare(s.Data[i*s.Size:(i+1)*s.Size], check) >= 0
+	pos = sort.Search(s.Len(), func(i int) bool {
+		return s.compare(s.Data[i*s.Size:(i+1)*s.Size], check) >= 0
 	})
+	found = pos*s.Size < len(s.Data) && s.compare(check, s.Data[pos*s.Size:(pos+1)*s.Size]) == 0
+	return
 }
 
 func (s *BinarySearcher) search(check []byte) (pos int, has bool) {
-	pos = s.Index(check)
-	has = pos*s.Size < len(s.Data) && bytes.Equal(check, s.Data[pos*s.Size:(pos+1)*s.Size])
-	return
+	return s.Find(check)
 }
 
 func (s *BinarySearcher) Contains(check []byte) bool {
@@ -0,0 +1,43 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package searcher
+
+import "testing"
+
+func TestFind(t *testing.T) {
+	s := New(recs('b', 'd', 'f'), 1)
+
+	tests := []struct {
+		check     byte
+		wantPos   int
+		wantFound bool
+	}{
+		{'a', 0, false},
+		{'b', 0, true},
+		{'c', 1, false},
+		{'d', 1, true},
+		{'f', 2, true},
+		{'g', 3, false},
+	}
+
+	for _, tt := range tests {
+		pos, found := s.Find(recs(tt.check))
+		if pos != tt.wantPos || found != tt.wantFound {
+			t.Errorf("Find(%q) = (%d, %v), want (%d, %v)", tt.check, pos, found, tt.wantPos, tt.wantFound)
+		}
+	}
+}
+
+func TestIndexMatchesFindPosition(t *testing.T) {
+	s := New(recs('b', 'd', 'f'), 1)
+
+	for _, c := range []byte{'a', 'b', 'c', 'd', 'f', 'g'} {
+		wantPos, _ := s.Find(recs(c))
+		if pos := s.Index(recs(c)); pos != wantPos {
+			t.Errorf("Index(%q) = %d, want %d (from Find)", c, pos, wantPos)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package searcher
+
+// InsertMany sorts records (a concatenation of zero or more Size-byte
+// records) and merges them with Data in a single O(n+m) pass,
+// deduplicating against both the existing records and each other. It
+// returns the number of records that were not already present.
+//
+// Unlike repeated calls to Insert, which shift the tail of Data on
+// every call, InsertMany is linear in the combined length of Data and
+// records, making it suitable for bulk loads.
+func (s *BinarySearcher) InsertMany(records []byte) int {
+	if len(records)%s.Size != 0 {
+		panic("invalid size")
+	}
+	if len(records) == 0 {
+		return 0
+	}
+
+	incoming := append([]byte(nil), records...)
+	(&BinarySearcher{Data: incoming, Size: s.Size, CompareFunc: s.CompareFunc}).Sort()
+	incoming = s.dedupeSorted(incoming)
+
+	merged := make([]byte, 0, len(s.Data)+len(incoming))
+	inserted := 0
+
+	i, j := 0, 0
+	for i < len(s.Data) && j < len(incoming) {
+		switch cmp := s.compare(s.Data[i:i+s.Size], incoming[j:j+s.Size]); {
+		case cmp == 0:
+			merged = append(merged, s.Data[i:i+s.Size]...)
+			i += s.Size
+			j += s.Size
+		case cmp < 0:
+			merged = append(merged, s.Data[i:i+s.Size]...)
+			i += s.Size
+		default:
+			merged = append(merged, incoming[j:j+s.Size]...)
+			inserted++
+			j += s.Size
+		}
+	}
+	merged = append(merged, s.Data[i:]...)
+	merged = append(merged, incoming[j:]...)
+	inserted += (len(incoming) - j) / s.Size
+
+	s.Data = merged
+	return inserted
+}
+
+// RemoveMany sorts records (a concatenation of zero or more Size-byte
+// records) and removes any matching records from Data in a single
+// O(n+m) pass. It returns the number of records removed.
+func (s *BinarySearcher) RemoveMany(records []byte) int {
+	if len(records)%s.Size != 0 {
+		panic("invalid size")
+	}
+	if len(records) == 0 || len(s.Data) == 0 {
+		return 0
+	}
+
+	incoming := append([]byte(nil), records...)
+	(&BinarySearcher{Data: incoming, Size: s.Size, CompareFunc: s.CompareFunc}).Sort()
+	incoming = s.dedupeSorted(incoming)
+
+	out := s.Data[:0]
+	removed := 0
+
+	i, j := 0, 0
+	for i < len(s.Data) {
+		if j < len(incoming) {
+			switch cmp := s.compare(s.Data[i:i+s.Size], incoming[j:j+s.Size]); {
+			case cmp == 0:
+				i += s.Size
+				j += s.Size
+				removed++
+				continue
+			case cmp > 0:
+				j += s.Size
+				continue
+			}
+		}
+
+		out = append(out, s.Data[i:i+s.Size]...)
+		i += s.Size
+	}
+
+	s.Data = out
+	return removed
+}
+
+// dedupeSorted compacts adjacent equal records in a sorted, size-byte
+// record slice, in place.
+func (s *BinarySearcher) dedupeSorted(data []byte) []byte {
+	if len(data) == 0 {
+		return data
+	}
+
+	size := s.Size
+	out := data[:size]
+	for i := size; i < len(data); i += size {
+		if s.compare(data[i:i+size], out[len(out)-size:]) != 0 {
+			out = append(out, data[i:i+size]...)
+		}
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package searcher
+
+import (
+	"bytes"
+	"testing"
+)
+
+func recs(vs ...byte) []byte {
+	return []byte(string(vs))
+}
+
+func TestInsertMany(t *testing.T) {
+	s := New(recs('b', 'd', 'f'), 1)
+
+	n := s.InsertMany(recs('a', 'c', 'c', 'd', 'e'))
+	if n != 3 {
+		t.Errorf("InsertMany returned %d, want 3", n)
+	}
+
+	if want := recs('a', 'b', 'c', 'd', 'e', 'f'); !bytes.Equal(s.Data, want) {
+		t.Errorf("Data = %q, want %q", s.Data, want)
+	}
+}
+
+func TestInsertManyEmpty(t *testing.T) {
+	s := New(recs('a', 'b'), 1)
+
+	if n := s.InsertMany(nil); n != 0 {
+		t.Errorf("InsertMany(nil) returned %d, want 0", n)
+	}
+	if want := recs('a', 'b'); !bytes.Equal(s.Data, want) {
+		t.Errorf("Data = %q, want %q", s.Data, want)
+	}
+}
+
+func TestRemoveMany(t *testing.T) {
+	s := New(recs('a', 'b', 'c', 'd', 'e'), 1)
+
+	n := s.RemoveMany(recs('a', 'c', 'c', 'e', 'z'))
+	if n != 3 {
+		t.Errorf("RemoveMany returned %d, want 3", n)
+	}
+
+	if want := recs('b', 'd'); !bytes.Equal(s.Data, want) {
+		t.Errorf("Data = %q, want %q", s.Data, want)
+	}
+}
+
+func TestInsertManyWithCompareFunc(t *testing.T) {
+	// Reverse lexical order.
+	reverse := func(a, b []byte) int { return bytes.Compare(b, a) }
+
+	s := New(recs('f', 'd', 'b'), 1)
+	s.CompareFunc = reverse
+
+	n := s.InsertMany(recs('e', 'c', 'c', 'a'))
+	if n != 3 {
+		t.Errorf("InsertMany returned %d, want 3", n)
+	}
+
+	if want := recs('f', 'e', 'd', 'c', 'b', 'a'); !bytes.Equal(s.Data, want) {
+		t.Errorf("Data = %q, want %q", s.Data, want)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package searcher
+
+import (
+	"bytes"
+	"testing"
+)
+
+// reverseCompare orders records in reverse lexical order, the simplest
+// non-default CompareFunc that still exercises Less/Find/Sort.
+func reverseCompare(a, b []byte) int {
+	return bytes.Compare(b, a)
+}
+
+func TestCompareFuncFind(t *testing.T) {
+	s := New(recs('f', 'd', 'b'), 1) // descending, per reverseCompare
+	s.CompareFunc = reverseCompare
+
+	tests := []struct {
+		check     byte
+		wantPos   int
+		wantFound bool
+	}{
+		{'g', 0, false},
+		{'f', 0, true},
+		{'e', 1, false},
+		{'d', 1, true},
+		{'b', 2, true},
+		{'a', 3, false},
+	}
+
+	for _, tt := range tests {
+		pos, found := s.Find(recs(tt.check))
+		if pos != tt.wantPos || found != tt.wantFound {
+			t.Errorf("Find(%q) = (%d, %v), want (%d, %v)", tt.check, pos, found, tt.wantPos, tt.wantFound)
+		}
+	}
+}
+
+func TestCompareFuncContainsInsert(t *testing.T) {
+	s := New(nil, 1)
+	s.CompareFunc = reverseCompare
+
+	for _, c := range []byte{'b', 'd', 'f'} {
+		if !s.Insert(recs(c)) {
+			t.Fatalf("Insert(%q) = false, want true", c)
+		}
+	}
+
+	if want := recs('f', 'd', 'b'); !bytes.Equal(s.Data, want) {
+		t.Fatalf("Data = %q, want %q (descending)", s.Data, want)
+	}
+
+	for _, c := range []byte{'b', 'd', 'f'} {
+		if !s.Contains(recs(c)) {
+			t.Errorf("Contains(%q) = false, want true", c)
+		}
+	}
+	if s.Contains(recs('c')) {
+		t.Error("Contains('c') = true, want false")
+	}
+}
+
+func TestCompareFuncSort(t *testing.T) {
+	s := New(recs('b', 'f', 'd'), 1)
+	s.CompareFunc = reverseCompare
+
+	s.Sort()
+
+	if want := recs('f', 'd', 'b'); !bytes.Equal(s.Data, want) {
+		t.Errorf("Sort() = %q, want %q (descending)", s.Data, want)
+	}
+}
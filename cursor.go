@@ -0,0 +1,68 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package searcher
+
+import "iter"
+
+// Range returns an iterator over the records in [lo, hi), without
+// allocating an intermediate slice. It is a natural extension of the
+// Index/InsertRange/RemoveRange family for callers that want to
+// stream results.
+func (s *BinarySearcher) Range(lo, hi []byte) iter.Seq[[]byte] {
+	return func(yield func([]byte) bool) {
+		loPos, _ := s.Find(lo)
+		hiPos, _ := s.Find(hi)
+
+		for i := loPos; i < hiPos; i++ {
+			if !yield(s.Data[i*s.Size : (i+1)*s.Size]) {
+				return
+			}
+		}
+	}
+}
+
+// Cursor is a lower-level, stateful alternative to Range that lets
+// callers seek to an arbitrary position and then walk forwards or
+// backwards one record at a time.
+type Cursor struct {
+	s   *BinarySearcher
+	pos int
+}
+
+// Cursor returns a new Cursor over s, initially positioned before the
+// first record.
+func (s *BinarySearcher) Cursor() *Cursor {
+	return &Cursor{s: s}
+}
+
+// Seek positions the cursor at the leftmost record greater than or
+// equal to check, as returned by Find.
+func (c *Cursor) Seek(check []byte) {
+	c.pos, _ = c.s.Find(check)
+}
+
+// Next returns the record at the cursor and advances it, or reports
+// false if the cursor is past the last record.
+func (c *Cursor) Next() ([]byte, bool) {
+	if c.pos >= c.s.Len() {
+		return nil, false
+	}
+
+	rec := c.s.Data[c.pos*c.s.Size : (c.pos+1)*c.s.Size]
+	c.pos++
+	return rec, true
+}
+
+// Prev moves the cursor back one record and returns it, or reports
+// false if the cursor is at the first record.
+func (c *Cursor) Prev() ([]byte, bool) {
+	if c.pos <= 0 {
+		return nil, false
+	}
+
+	c.pos--
+	return c.s.Data[c.pos*c.s.Size : (c.pos+1)*c.s.Size], true
+}
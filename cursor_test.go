@@ -0,0 +1,66 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package searcher
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	s := New(recs('a', 'b', 'c', 'd', 'e'), 1)
+
+	var got []byte
+	for rec := range s.Range(recs('b'), recs('e')) {
+		got = append(got, rec...)
+	}
+
+	if want := recs('b', 'c', 'd'); !bytes.Equal(got, want) {
+		t.Errorf("Range(b, e) = %q, want %q", got, want)
+	}
+}
+
+func TestRangeStopsEarly(t *testing.T) {
+	s := New(recs('a', 'b', 'c', 'd', 'e'), 1)
+
+	var got []byte
+	for rec := range s.Range(recs('a'), recs('e')) {
+		got = append(got, rec...)
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	if want := recs('a', 'b'); !bytes.Equal(got, want) {
+		t.Errorf("Range(a, e) stopped early = %q, want %q", got, want)
+	}
+}
+
+func TestCursor(t *testing.T) {
+	s := New(recs('a', 'c', 'e'), 1)
+	c := s.Cursor()
+
+	c.Seek(recs('c'))
+
+	rec, ok := c.Next()
+	if !ok || !bytes.Equal(rec, recs('c')) {
+		t.Fatalf("Next() = (%q, %v), want (\"c\", true)", rec, ok)
+	}
+
+	rec, ok = c.Next()
+	if !ok || !bytes.Equal(rec, recs('e')) {
+		t.Fatalf("Next() = (%q, %v), want (\"e\", true)", rec, ok)
+	}
+
+	if _, ok := c.Next(); ok {
+		t.Fatal("Next() past the last record returned true, want false")
+	}
+
+	rec, ok = c.Prev()
+	if !ok || !bytes.Equal(rec, recs('e')) {
+		t.Fatalf("Prev() = (%q, %v), want (\"e\", true)", rec, ok)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package searcher
+
+// addIntToBytes adds n to the big-endian unsigned integer stored in b,
+// in place, and reports whether the addition overflowed b.
+func addIntToBytes(b []byte, n int) (overflow bool) {
+	if n < 0 {
+		panic("n is negative")
+	}
+
+	carry := uint64(n)
+	for i := len(b) - 1; i >= 0 && carry != 0; i-- {
+		sum := uint64(b[i]) + carry
+		b[i] = byte(sum)
+		carry = sum >> 8
+	}
+
+	return carry != 0
+}
+
+// incrementBytes fills data, a concatenation of one or more
+// len(base)-sized records, with successive big-endian integers
+// starting at base: base, base+1, base+2, and so on. It is the
+// default IncrementBytes used by New, and backs InsertRange's
+// insertion of a contiguous range of keys.
+func incrementBytes(base, data []byte) {
+	size := len(base)
+	if size == 0 {
+		return
+	}
+
+	for i := 0; i < len(data); i += size {
+		copy(data[i:i+size], base)
+		if i > 0 {
+			addIntToBytes(data[i:i+size], i/size)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package sstable
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// bloomFilter is a simple Kirsch-Mitzenmacher double-hashed bloom
+// filter used to short-circuit negative Contains/Find lookups without
+// touching the mmap'd data blocks.
+type bloomFilter struct {
+	bits []byte
+	k    int
+}
+
+// newBloomFilter returns a bloom filter sized for n entries with a
+// false-positive rate of roughly p.
+func newBloomFilter(n int, p float64) *bloomFilter {
+	if n <= 0 {
+		n = 1
+	}
+
+	m := bloomSize(n, p)
+	k := bloomHashCount(m, n)
+
+	return &bloomFilter{
+		bits: make([]byte, (m+7)/8),
+		k:    k,
+	}
+}
+
+func bloomSize(n int, p float64) int {
+	// m = -n*ln(p) / (ln(2)^2)
+	const ln2Squared = 0.4804530139182014 // ln(2)^2
+	m := int(-float64(n) * math.Log(p) / ln2Squared)
+	if m < 8 {
+		m = 8
+	}
+	return m
+}
+
+func bloomHashCount(m, n int) int {
+	k := int(float64(m) / float64(n) * 0.6931471805599453) // m/n * ln(2)
+	if k < 1 {
+		k = 1
+	}
+	return k
+}
+
+func (b *bloomFilter) add(key []byte) {
+	h1, h2 := bloomHashes(key)
+	nbits := uint64(len(b.bits)) * 8
+
+	for i := 0; i < b.k; i++ {
+		idx := (h1 + uint64(i)*h2) % nbits
+		b.bits[idx/8] |= 1 << (idx % 8)
+	}
+}
+
+func (b *bloomFilter) test(key []byte) bool {
+	h1, h2 := bloomHashes(key)
+	nbits := uint64(len(b.bits)) * 8
+
+	for i := 0; i < b.k; i++ {
+		idx := (h1 + uint64(i)*h2) % nbits
+		if b.bits[idx/8]&(1<<(idx%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// encode serialises the bloom filter as k (uint32) followed by the
+// raw bitset.
+func (b *bloomFilter) encode() []byte {
+	buf := make([]byte, 4+len(b.bits))
+	binary.LittleEndian.PutUint32(buf, uint32(b.k))
+	copy(buf[4:], b.bits)
+	return buf
+}
+
+// decodeBloomFilter parses a bloom filter encoded by encode. It
+// rejects a filter with no bitset bytes or a zero hash count, since
+// add/test would otherwise divide by zero on the first lookup.
+func decodeBloomFilter(buf []byte) (*bloomFilter, error) {
+	if len(buf) < 5 {
+		return nil, ErrInvalidMagic
+	}
+
+	k := int(binary.LittleEndian.Uint32(buf))
+	if k < 1 {
+		return nil, ErrInvalidMagic
+	}
+
+	bits := make([]byte, len(buf)-4)
+	copy(bits, buf[4:])
+
+	return &bloomFilter{bits: bits, k: k}, nil
+}
+
+func bloomHashes(key []byte) (h1, h2 uint64) {
+	// FNV-1a, twice, with different seeds, to derive two independent
+	// hashes for double hashing.
+	const (
+		offset64 = 14695981039346656037
+		prime64  = 1099511628211
+	)
+
+	h1 = offset64
+	for _, c := range key {
+		h1 ^= uint64(c)
+		h1 *= prime64
+	}
+
+	h2 = offset64 ^ 0x9e3779b97f4a7c15
+	for _, c := range key {
+		h2 ^= uint64(c)
+		h2 *= prime64
+	}
+	if h2 == 0 {
+		h2 = 1
+	}
+
+	return h1, h2
+}
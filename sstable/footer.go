@@ -0,0 +1,50 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package sstable
+
+import "encoding/binary"
+
+func (f *footer) encode() []byte {
+	buf := make([]byte, footerSize)
+
+	binary.LittleEndian.PutUint64(buf[0:], f.indexOffset)
+	binary.LittleEndian.PutUint64(buf[8:], f.indexSize)
+	binary.LittleEndian.PutUint64(buf[16:], f.bloomOffset)
+	binary.LittleEndian.PutUint64(buf[24:], f.bloomSize)
+	binary.LittleEndian.PutUint64(buf[32:], f.numRecords)
+	binary.LittleEndian.PutUint32(buf[40:], f.recordSize)
+	binary.LittleEndian.PutUint32(buf[44:], f.blockSize)
+	binary.LittleEndian.PutUint64(buf[48:], f.magic)
+	binary.LittleEndian.PutUint32(buf[56:], f.ver)
+
+	return buf
+}
+
+func decodeFooter(buf []byte) (footer, error) {
+	var f footer
+	if len(buf) != footerSize {
+		return f, ErrInvalidMagic
+	}
+
+	f.indexOffset = binary.LittleEndian.Uint64(buf[0:])
+	f.indexSize = binary.LittleEndian.Uint64(buf[8:])
+	f.bloomOffset = binary.LittleEndian.Uint64(buf[16:])
+	f.bloomSize = binary.LittleEndian.Uint64(buf[24:])
+	f.numRecords = binary.LittleEndian.Uint64(buf[32:])
+	f.recordSize = binary.LittleEndian.Uint32(buf[40:])
+	f.blockSize = binary.LittleEndian.Uint32(buf[44:])
+	f.magic = binary.LittleEndian.Uint64(buf[48:])
+	f.ver = binary.LittleEndian.Uint32(buf[56:])
+
+	if f.magic != magic {
+		return f, ErrInvalidMagic
+	}
+	if f.ver != version {
+		return f, ErrUnsupportedVersion
+	}
+
+	return f, nil
+}
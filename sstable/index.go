@@ -0,0 +1,64 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package sstable
+
+import "encoding/binary"
+
+// indexEntry is one entry of the sparse index: the offset and record
+// count of a data block, plus the first key stored in that block.
+//
+// basePos is the position of the block's first record in the table as
+// a whole, precomputed once when the index is loaded so that Find
+// stays logarithmic instead of summing every preceding block's count
+// on each lookup.
+type indexEntry struct {
+	offset  uint64
+	count   uint32
+	key     []byte
+	basePos int
+}
+
+// entrySize returns the on-disk size of an indexEntry for the given
+// record size.
+func entrySize(recordSize int) int {
+	return 8 + 4 + recordSize
+}
+
+func encodeIndex(entries []indexEntry, recordSize int) []byte {
+	buf := make([]byte, 0, len(entries)*entrySize(recordSize))
+
+	for _, e := range entries {
+		var head [12]byte
+		binary.LittleEndian.PutUint64(head[0:], e.offset)
+		binary.LittleEndian.PutUint32(head[8:], e.count)
+
+		buf = append(buf, head[:]...)
+		buf = append(buf, e.key...)
+	}
+
+	return buf
+}
+
+func decodeIndex(buf []byte, recordSize int) []indexEntry {
+	size := entrySize(recordSize)
+	entries := make([]indexEntry, 0, len(buf)/size)
+
+	basePos := 0
+	for len(buf) >= size {
+		e := indexEntry{
+			offset:  binary.LittleEndian.Uint64(buf[0:]),
+			count:   binary.LittleEndian.Uint32(buf[8:]),
+			key:     buf[12:size:size],
+			basePos: basePos,
+		}
+
+		entries = append(entries, e)
+		basePos += int(e.count)
+		buf = buf[size:]
+	}
+
+	return entries
+}
@@ -0,0 +1,166 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package sstable
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+
+	"golang.org/x/sys/unix"
+)
+
+// Reader provides read-only, memory-mapped access to an sstable file
+// written by Writer. Only the sparse index (and optional bloom
+// filter) is loaded into the heap on Open; data blocks are served
+// directly from the mmap'd file.
+type Reader struct {
+	f    *os.File
+	data []byte // mmap of the whole file
+
+	recordSize      int
+	recordsPerBlock int
+
+	index []indexEntry
+	bloom *bloomFilter
+}
+
+// Open memory-maps the sstable file at path for reading.
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := newReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+func newReader(f *os.File) (*Reader, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := fi.Size()
+	if size < footerSize {
+		return nil, ErrInvalidMagic
+	}
+
+	data, err := unix.Mmap(int(f.Fd()), 0, int(size), unix.PROT_READ, unix.MAP_SHARED)
+	if err != nil {
+		return nil, fmt.Errorf("sstable: mmap: %w", err)
+	}
+
+	ft, err := decodeFooter(data[size-footerSize:])
+	if err != nil {
+		unix.Munmap(data)
+		return nil, err
+	}
+
+	indexBuf, err := sliceRange(data, ft.indexOffset, ft.indexSize, uint64(size))
+	if err != nil {
+		unix.Munmap(data)
+		return nil, err
+	}
+
+	r := &Reader{
+		f:          f,
+		data:       data,
+		recordSize: int(ft.recordSize),
+		index:      decodeIndex(indexBuf, int(ft.recordSize)),
+	}
+	r.recordsPerBlock = int(ft.blockSize) / r.recordSize
+	if r.recordsPerBlock < 1 {
+		r.recordsPerBlock = 1
+	}
+
+	for _, e := range r.index {
+		if _, err := sliceRange(data, e.offset, uint64(e.count)*uint64(r.recordSize), uint64(size)); err != nil {
+			unix.Munmap(data)
+			return nil, err
+		}
+	}
+
+	if ft.bloomSize > 0 {
+		bloomBuf, err := sliceRange(data, ft.bloomOffset, ft.bloomSize, uint64(size))
+		if err != nil {
+			unix.Munmap(data)
+			return nil, err
+		}
+		r.bloom, err = decodeBloomFilter(bloomBuf)
+		if err != nil {
+			unix.Munmap(data)
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// sliceRange returns data[off:off+n], after checking that the range
+// falls within a file of the given total size, so that a truncated or
+// corrupted sstable file yields ErrInvalidMagic instead of an
+// out-of-range slice panic.
+func sliceRange(data []byte, off, n, size uint64) ([]byte, error) {
+	if off > size || n > size-off {
+		return nil, ErrInvalidMagic
+	}
+	return data[off : off+n], nil
+}
+
+// Close unmaps the file and closes the underlying descriptor.
+func (r *Reader) Close() error {
+	if err := unix.Munmap(r.data); err != nil {
+		return err
+	}
+	return r.f.Close()
+}
+
+// Find returns the leftmost position of check and reports whether it
+// was found, mirroring searcher.BinarySearcher.Find. pos is always the
+// correct insertion position, even when check is absent; unlike
+// Contains, Find cannot use the bloom filter to skip a data block,
+// since it must still report where check belongs.
+func (r *Reader) Find(check []byte) (pos int, found bool) {
+	if len(check) != r.recordSize {
+		panic("invalid size")
+	}
+
+	blockIdx := sort.Search(len(r.index), func(i int) bool {
+		return bytes.Compare(r.index[i].key, check) > 0
+	}) - 1
+	if blockIdx < 0 {
+		return 0, false
+	}
+
+	e := r.index[blockIdx]
+	block := r.data[e.offset : e.offset+uint64(e.count)*uint64(r.recordSize)]
+
+	localPos := sort.Search(int(e.count), func(i int) bool {
+		return bytes.Compare(block[i*r.recordSize:(i+1)*r.recordSize], check) >= 0
+	})
+	pos = e.basePos + localPos
+
+	found = localPos < int(e.count) && bytes.Equal(check, block[localPos*r.recordSize:(localPos+1)*r.recordSize])
+	return
+}
+
+// Contains reports whether check is present. If a bloom filter was
+// written for this table, a negative match short-circuits before
+// touching the mmap'd data block.
+func (r *Reader) Contains(check []byte) bool {
+	if r.bloom != nil && !r.bloom.test(check) {
+		return false
+	}
+
+	_, found := r.Find(check)
+	return found
+}
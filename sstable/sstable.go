@@ -0,0 +1,68 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+// Package sstable implements an immutable, memory-mapped, on-disk
+// encoding of a sorted searcher.BinarySearcher, laid out as a sequence
+// of fixed-size-record data blocks with a sparse in-memory index, in
+// the style of an LSM SSTable.
+//
+// A file written by Writer is read-only: it is opened once by Reader
+// and then queried with Contains/Find without loading the full file
+// into the heap, making it suitable for sets too large to hold
+// entirely in memory.
+package sstable
+
+import "errors"
+
+// magic identifies an sstable file. It is written as the 8 bytes at
+// offset 48 of the footer, just before the version.
+const magic uint64 = 0x62737374307865 // "bsst0xe"
+
+// version is the on-disk format version written by this package.
+const version uint32 = 1
+
+// defaultBlockSize is the target size, in bytes, of each data block.
+// A block holds as many whole records as fit within it, so the actual
+// block size varies with the record size.
+const defaultBlockSize = 4096
+
+// footerSize is the fixed size, in bytes, of the footer trailer:
+// indexOffset, indexSize, bloomOffset, bloomSize, numRecords (uint64),
+// recordSize, blockSize (uint32), magic (uint64), version (uint32).
+const footerSize = 8*6 + 4*3
+
+var (
+	// ErrInvalidMagic is returned by Open when the file does not end
+	// with a valid sstable footer, or when the index, a data block or
+	// the bloom filter it describes is malformed or lies outside the
+	// file.
+	ErrInvalidMagic = errors.New("sstable: invalid magic")
+
+	// ErrUnsupportedVersion is returned by Open when the file was
+	// written by an incompatible, newer version of this package.
+	ErrUnsupportedVersion = errors.New("sstable: unsupported version")
+
+	// ErrRecordSize is returned when a record does not match the
+	// table's fixed record size.
+	ErrRecordSize = errors.New("sstable: invalid record size")
+
+	// ErrNotSorted is returned by Writer.Write when records are not
+	// written in strictly ascending order.
+	ErrNotSorted = errors.New("sstable: records must be written in ascending order")
+)
+
+// footer is the trailer written at the end of every sstable file, used
+// to locate the sparse index and optional bloom filter on Open.
+type footer struct {
+	indexOffset uint64
+	indexSize   uint64
+	bloomOffset uint64
+	bloomSize   uint64
+	numRecords  uint64
+	recordSize  uint32
+	blockSize   uint32
+	magic       uint64
+	ver         uint32
+}
@@ -0,0 +1,177 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package sstable
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testRecordSize = 4
+
+func encodeKey(v uint32) []byte {
+	b := make([]byte, testRecordSize)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+// writeTestTable writes records for every even key in [0, 2*n) using a
+// small block size so the sparse index spans multiple blocks.
+func writeTestTable(t *testing.T, n int, opts ...Option) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "table.sst")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := NewWriter(f, testRecordSize, append([]Option{WithBlockSize(16)}, opts...)...)
+	for i := 0; i < n; i++ {
+		if err := w.Write(encodeKey(uint32(i * 2))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestReaderFind(t *testing.T) {
+	const n = 50
+	path := writeTestTable(t, n)
+
+	r, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	for v := uint32(0); v < 2*n; v++ {
+		pos, found := r.Find(encodeKey(v))
+
+		wantFound := v%2 == 0
+		wantPos := int(v+1) / 2
+
+		if found != wantFound || pos != wantPos {
+			t.Errorf("Find(%d) = (%d, %v), want (%d, %v)", v, pos, found, wantPos, wantFound)
+		}
+	}
+}
+
+func TestReaderContainsWithBloomFilter(t *testing.T) {
+	const n = 50
+	path := writeTestTable(t, n, WithBloomFilter(n, 0.01))
+
+	r, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if r.bloom == nil {
+		t.Fatal("expected a bloom filter to be attached")
+	}
+
+	for v := uint32(0); v < 2*n; v++ {
+		if got, want := r.Contains(encodeKey(v)), v%2 == 0; got != want {
+			t.Errorf("Contains(%d) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+// TestOpenTruncated simulates a file truncated before its data and
+// index blocks but with an intact footer, so decodeFooter succeeds
+// while the footer's offsets point past the end of the file.
+func TestOpenTruncated(t *testing.T) {
+	path := writeTestTable(t, 50)
+
+	full, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	truncated := filepath.Join(t.TempDir(), "truncated.sst")
+	if err := os.WriteFile(truncated, full[len(full)-footerSize:], 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Open(truncated); err != ErrInvalidMagic {
+		t.Fatalf("Open of a truncated file = %v, want %v", err, ErrInvalidMagic)
+	}
+}
+
+// TestOpenCorruptedBlockOffset corrupts a data-block offset within an
+// otherwise-intact sparse index, so the per-entry range it describes
+// falls outside the file, rather than the footer/index ranges covered
+// by TestOpenTruncated.
+func TestOpenCorruptedBlockOffset(t *testing.T) {
+	path := writeTestTable(t, 50)
+
+	full, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ft, err := decodeFooter(full[len(full)-footerSize:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	corrupted := append([]byte(nil), full...)
+	// Point the first index entry's block just short of EOF, so
+	// offset+count*recordSize overflows the file.
+	binary.LittleEndian.PutUint64(corrupted[ft.indexOffset:], uint64(len(full))-4)
+
+	path2 := filepath.Join(t.TempDir(), "corrupt-offset.sst")
+	if err := os.WriteFile(path2, corrupted, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Open(path2); err != ErrInvalidMagic {
+		t.Fatalf("Open with a corrupted block offset = %v, want %v", err, ErrInvalidMagic)
+	}
+}
+
+// TestOpenCorruptedBloomFilter shrinks the footer's recorded bloom
+// filter size down to just its k header with no bitset bytes, which
+// would otherwise make add/test divide by zero on first use.
+func TestOpenCorruptedBloomFilter(t *testing.T) {
+	path := writeTestTable(t, 50, WithBloomFilter(50, 0.01))
+
+	full, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	footerStart := len(full) - footerSize
+	ft, err := decodeFooter(full[footerStart:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ft.bloomSize == 0 {
+		t.Fatal("expected a bloom filter in the footer")
+	}
+
+	corrupted := append([]byte(nil), full...)
+	binary.LittleEndian.PutUint64(corrupted[footerStart+24:], 4) // bloomSize field
+
+	path2 := filepath.Join(t.TempDir(), "corrupt-bloom.sst")
+	if err := os.WriteFile(path2, corrupted, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Open(path2); err != ErrInvalidMagic {
+		t.Fatalf("Open with a truncated bloom filter = %v, want %v", err, ErrInvalidMagic)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package sstable
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// Option configures a Writer.
+type Option func(*Writer)
+
+// WithBlockSize sets the target size, in bytes, of each data block.
+// The default is 4KiB.
+func WithBlockSize(n int) Option {
+	return func(w *Writer) {
+		w.blockSize = n
+	}
+}
+
+// WithBloomFilter enables an optional bloom filter sized for n
+// expected records at a target false-positive rate of p, used by
+// Reader to short-circuit negative lookups.
+func WithBloomFilter(n int, p float64) Option {
+	return func(w *Writer) {
+		w.bloom = newBloomFilter(n, p)
+	}
+}
+
+// Writer streams sorted, fixed-size records to build an sstable file.
+// Records must be written in strictly ascending order, matching the
+// order a sorted searcher.BinarySearcher already stores them in.
+type Writer struct {
+	w          *bufio.Writer
+	recordSize int
+	blockSize  int
+	bloom      *bloomFilter
+
+	recordsPerBlock int
+	offset          uint64
+	numRecords      uint64
+
+	block      []byte
+	blockCount uint32
+	blockFirst []byte
+
+	index []indexEntry
+	last  []byte
+}
+
+// NewWriter returns a Writer that writes fixed-size records of
+// recordSize bytes to w.
+func NewWriter(w io.Writer, recordSize int, opts ...Option) *Writer {
+	wr := &Writer{
+		w:          bufio.NewWriter(w),
+		recordSize: recordSize,
+		blockSize:  defaultBlockSize,
+	}
+
+	for _, opt := range opts {
+		opt(wr)
+	}
+
+	wr.recordsPerBlock = wr.blockSize / recordSize
+	if wr.recordsPerBlock < 1 {
+		wr.recordsPerBlock = 1
+	}
+	wr.block = make([]byte, 0, wr.recordsPerBlock*recordSize)
+
+	return wr
+}
+
+// Write appends record, which must be recordSize bytes and strictly
+// greater than the previously written record.
+func (w *Writer) Write(record []byte) error {
+	if len(record) != w.recordSize {
+		return ErrRecordSize
+	}
+	if w.last != nil && bytes.Compare(record, w.last) <= 0 {
+		return ErrNotSorted
+	}
+
+	if w.bloom != nil {
+		w.bloom.add(record)
+	}
+
+	if w.blockCount == 0 {
+		w.blockFirst = append([]byte(nil), record...)
+	}
+
+	w.block = append(w.block, record...)
+	w.blockCount++
+	w.numRecords++
+
+	if w.last == nil {
+		w.last = make([]byte, w.recordSize)
+	}
+	copy(w.last, record)
+
+	if int(w.blockCount) == w.recordsPerBlock {
+		return w.flushBlock()
+	}
+	return nil
+}
+
+func (w *Writer) flushBlock() error {
+	if w.blockCount == 0 {
+		return nil
+	}
+
+	w.index = append(w.index, indexEntry{
+		offset: w.offset,
+		count:  w.blockCount,
+		key:    w.blockFirst,
+	})
+
+	n, err := w.w.Write(w.block)
+	w.offset += uint64(n)
+	if err != nil {
+		return err
+	}
+
+	w.block = w.block[:0]
+	w.blockCount = 0
+	return nil
+}
+
+// Close flushes any buffered records, writes the sparse index,
+// optional bloom filter, and footer, and returns any write error.
+func (w *Writer) Close() error {
+	if err := w.flushBlock(); err != nil {
+		return err
+	}
+
+	f := footer{
+		recordSize: uint32(w.recordSize),
+		blockSize:  uint32(w.blockSize),
+		numRecords: w.numRecords,
+		magic:      magic,
+		ver:        version,
+	}
+
+	indexBuf := encodeIndex(w.index, w.recordSize)
+	f.indexOffset = w.offset
+	f.indexSize = uint64(len(indexBuf))
+	if _, err := w.w.Write(indexBuf); err != nil {
+		return err
+	}
+	w.offset += f.indexSize
+
+	if w.bloom != nil {
+		bloomBuf := w.bloom.encode()
+		f.bloomOffset = w.offset
+		f.bloomSize = uint64(len(bloomBuf))
+		if _, err := w.w.Write(bloomBuf); err != nil {
+			return err
+		}
+		w.offset += f.bloomSize
+	}
+
+	if _, err := w.w.Write(f.encode()); err != nil {
+		return err
+	}
+
+	return w.w.Flush()
+}
@@ -0,0 +1,89 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package searcher
+
+import "iter"
+
+// Searcher is a generic, typed wrapper around BinarySearcher. It
+// marshals values of type T to and from the fixed-size byte records
+// that BinarySearcher stores, so callers no longer need to manage the
+// Size bookkeeping themselves.
+type Searcher[T any] struct {
+	s *BinarySearcher
+
+	Marshal   func(T) []byte
+	Unmarshal func([]byte) T
+}
+
+// NewSearcher returns a Searcher for fixed-size records of size bytes,
+// using marshal and unmarshal to convert between T and its byte
+// representation.
+func NewSearcher[T any](size int, marshal func(T) []byte, unmarshal func([]byte) T) *Searcher[T] {
+	return &Searcher[T]{
+		s: New(nil, size),
+
+		Marshal:   marshal,
+		Unmarshal: unmarshal,
+	}
+}
+
+// Len returns the number of records stored.
+func (s *Searcher[T]) Len() int {
+	return s.s.Len()
+}
+
+// Find returns the leftmost position of v and reports whether it was
+// found, see BinarySearcher.Find.
+func (s *Searcher[T]) Find(v T) (pos int, found bool) {
+	return s.s.Find(s.Marshal(v))
+}
+
+// Contains reports whether v is present.
+func (s *Searcher[T]) Contains(v T) bool {
+	return s.s.Contains(s.Marshal(v))
+}
+
+// Insert inserts v, reporting whether it was not already present.
+func (s *Searcher[T]) Insert(v T) bool {
+	return s.s.Insert(s.Marshal(v))
+}
+
+// Remove removes v, reporting whether it was present.
+func (s *Searcher[T]) Remove(v T) bool {
+	return s.s.Remove(s.Marshal(v))
+}
+
+// InsertMany merges vs into the set in a single pass, see
+// BinarySearcher.InsertMany. It returns the number of values that
+// were not already present.
+func (s *Searcher[T]) InsertMany(vs []T) int {
+	return s.s.InsertMany(s.marshalAll(vs))
+}
+
+// RemoveMany removes vs from the set in a single pass, see
+// BinarySearcher.RemoveMany. It returns the number of values removed.
+func (s *Searcher[T]) RemoveMany(vs []T) int {
+	return s.s.RemoveMany(s.marshalAll(vs))
+}
+
+func (s *Searcher[T]) marshalAll(vs []T) []byte {
+	buf := make([]byte, 0, len(vs)*s.s.Size)
+	for _, v := range vs {
+		buf = append(buf, s.Marshal(v)...)
+	}
+	return buf
+}
+
+// Range returns an iterator over the records in [lo, hi).
+func (s *Searcher[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for rec := range s.s.Range(s.Marshal(lo), s.Marshal(hi)) {
+			if !yield(s.Unmarshal(rec)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2016 Tom Thorogood. All rights reserved.
+// Use of this source code is governed by a
+// Modified BSD License license that can be found in
+// the LICENSE file.
+
+package searcher
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func marshalUint32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+func unmarshalUint32(b []byte) uint32 {
+	return binary.BigEndian.Uint32(b)
+}
+
+func newUint32Searcher() *Searcher[uint32] {
+	return NewSearcher(4, marshalUint32, unmarshalUint32)
+}
+
+func TestSearcherInsertContainsFind(t *testing.T) {
+	s := newUint32Searcher()
+
+	for _, v := range []uint32{5, 1, 3} {
+		if !s.Insert(v) {
+			t.Fatalf("Insert(%d) = false, want true", v)
+		}
+	}
+	if s.Insert(3) {
+		t.Fatal("Insert of a duplicate returned true, want false")
+	}
+
+	if s.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", s.Len())
+	}
+
+	for _, v := range []uint32{1, 3, 5} {
+		if !s.Contains(v) {
+			t.Errorf("Contains(%d) = false, want true", v)
+		}
+	}
+	if s.Contains(2) {
+		t.Error("Contains(2) = true, want false")
+	}
+
+	if pos, found := s.Find(3); pos != 1 || !found {
+		t.Errorf("Find(3) = (%d, %v), want (1, true)", pos, found)
+	}
+	if pos, found := s.Find(2); pos != 1 || found {
+		t.Errorf("Find(2) = (%d, %v), want (1, false)", pos, found)
+	}
+
+	if !s.Remove(3) {
+		t.Fatal("Remove(3) = false, want true")
+	}
+	if s.Contains(3) {
+		t.Error("Contains(3) after Remove = true, want false")
+	}
+}
+
+func TestSearcherRange(t *testing.T) {
+	s := newUint32Searcher()
+	s.InsertMany([]uint32{1, 2, 3, 4, 5})
+
+	var got []uint32
+	for v := range s.Range(2, 5) {
+		got = append(got, v)
+	}
+
+	want := []uint32{2, 3, 4}
+	if len(got) != len(want) {
+		t.Fatalf("Range(2, 5) = %v, want %v", got, want)
+	}
+	for i, v := range want {
+		if got[i] != v {
+			t.Errorf("Range(2, 5)[%d] = %d, want %d", i, got[i], v)
+		}
+	}
+}